@@ -0,0 +1,102 @@
+package slogr
+
+import (
+	"io"
+	"log/slog"
+)
+
+// With returns a new Logger that includes the given attributes in every
+// subsequent log record, mirroring slog.Logger.With. The returned Logger
+// shares this Logger's handler, level, and registered context attr funcs.
+func (logger *Logger) With(args ...any) *Logger {
+	clone := logger.shallowCopy()
+	clone.slogger.Store(logger.slogger.Load().With(args...))
+	return clone
+}
+
+// WithGroup returns a new Logger that nests every subsequent attribute under
+// the given group name, mirroring slog.Logger.WithGroup.
+func (logger *Logger) WithGroup(name string) *Logger {
+	clone := logger.shallowCopy()
+	clone.slogger.Store(logger.slogger.Load().WithGroup(name))
+	return clone
+}
+
+func (logger *Logger) shallowCopy() *Logger {
+	clone := &Logger{
+		levelVar:                     logger.levelVar,
+		shouldPreficMessageWithLevel: logger.shouldPreficMessageWithLevel,
+		handlerType:                  logger.handlerType,
+		writerType:                   logger.writerType,
+		ExitFunc:                     logger.ExitFunc,
+		userReplaceAttr:              logger.userReplaceAttr,
+	}
+	if logger.options != nil {
+		clone.options = logger.options.clone()
+	}
+	clone.contextAttrFuncs.Store(logger.contextAttrFuncs.Load())
+	clone.slogger.Store(logger.slogger.Load())
+	return clone
+}
+
+// Option overrides a single aspect of a Logger's configuration when passed to
+// Clone.
+type Option func(output *io.Writer, opts *Options)
+
+// WithLevel overrides the level of the cloned Logger.
+func WithLevel(level slog.Level) Option {
+	return func(_ *io.Writer, opts *Options) { opts.Level = level }
+}
+
+// WithHandlerType overrides the handler type of the cloned Logger.
+func WithHandlerType(handlerType HandlerType) Option {
+	return func(_ *io.Writer, opts *Options) { opts.HandlerType = handlerType }
+}
+
+// WithOutput overrides the output destination of the cloned Logger.
+func WithOutput(output io.Writer) Option {
+	return func(o *io.Writer, _ *Options) { *o = output }
+}
+
+// clone returns a copy of o safe to mutate independently of o, used by
+// Logger.Clone so building the cloned Logger never mutates the original's
+// Options.
+func (o *Options) clone() *Options {
+	c := *o
+	c.WithContextAttrs = append([]ContextAttrFunc(nil), o.WithContextAttrs...)
+	if o.HandlerOptions != nil {
+		handlerOptions := *o.HandlerOptions
+		c.HandlerOptions = &handlerOptions
+	}
+	return &c
+}
+
+// Clone returns a new Logger configured like logger but with any given Option
+// overrides applied. Every other field the logger was constructed with -
+// CustomHandler, trace correlation, attribute key renaming, lowercase
+// levels, and so on - carries over unchanged. Useful for branching a logger
+// with a different level, handler type, or output without affecting the
+// original.
+func (logger *Logger) Clone(opts ...Option) *Logger {
+	options := DefaultOptions()
+	if logger.options != nil {
+		options = logger.options.clone()
+	}
+	options.Level = logger.levelVar.Level()
+	options.AddLevelPrefix = logger.shouldPreficMessageWithLevel
+	options.HandlerType = logger.handlerType
+	options.WithContextAttrs = logger.loadContextAttrFuncs()
+	if options.HandlerOptions != nil {
+		// Reset to the true caller-supplied ReplaceAttr so New recomposes it
+		// fresh instead of layering composeReplaceAttr on top of itself.
+		options.HandlerOptions.ReplaceAttr = logger.userReplaceAttr
+	}
+
+	output := logger.writerType
+
+	for _, opt := range opts {
+		opt(&output, options)
+	}
+
+	return New(output, options)
+}
@@ -2,6 +2,7 @@ package slogr
 
 import (
 	"context"
+	"log/slog"
 )
 
 // ContextKey is a type used for context keys to avoid collisions
@@ -24,4 +25,34 @@ func FromContext(ctx context.Context) *Logger {
 		return logger
 	}
 	return nil
+}
+
+// ContextAttrFunc extracts slog attributes from an incoming context. Register
+// one with RegisterContextAttrFunc (or Options.WithContextAttrs) to have every
+// Log/Debug/Info/... call automatically include attributes pulled from ctx -
+// request IDs, tenant IDs, user IDs, trace IDs - without threading them through
+// every call site.
+type ContextAttrFunc func(ctx context.Context) []slog.Attr
+
+// DefaultContextAttrFuncs are the context attribute extractors registered
+// against the package-level default logger.
+var DefaultContextAttrFuncs []ContextAttrFunc
+
+// RegisterContextAttrFunc registers fn with the default logger.
+func RegisterContextAttrFunc(fn ContextAttrFunc) {
+	defaultLogger.RegisterContextAttrFunc(fn)
+}
+
+// RegisterContextAttrFunc registers fn so that its return value is appended to
+// every record logged through logger.
+func (logger *Logger) RegisterContextAttrFunc(fn ContextAttrFunc) {
+	existing := logger.loadContextAttrFuncs()
+	updated := make([]ContextAttrFunc, len(existing)+1)
+	copy(updated, existing)
+	updated[len(existing)] = fn
+	logger.contextAttrFuncs.Store(&updated)
+
+	if logger == defaultLogger {
+		DefaultContextAttrFuncs = updated
+	}
 }
\ No newline at end of file
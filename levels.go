@@ -0,0 +1,57 @@
+package slogr
+
+import (
+	"log/slog"
+	"strings"
+)
+
+const (
+	// LevelTrace is a verbosity level below slog.LevelDebug for extremely
+	// fine-grained diagnostics.
+	LevelTrace slog.Level = slog.LevelDebug - 4
+
+	// LevelFatal is a level above slog.LevelError for conditions that should
+	// terminate the process after being logged.
+	LevelFatal slog.Level = slog.LevelError + 4
+)
+
+// levelLabel returns the canonical upper-case name for level, treating
+// LevelTrace and LevelFatal as first-class names rather than the
+// "DEBUG-4"/"ERROR+4" strings slog.Level.String() would otherwise produce.
+func levelLabel(level slog.Level) string {
+	switch {
+	case level < slog.LevelDebug:
+		return "TRACE"
+	case level < slog.LevelInfo:
+		return "DEBUG"
+	case level < slog.LevelWarn:
+		return "INFO"
+	case level < slog.LevelError:
+		return "WARN"
+	case level < LevelFatal:
+		return "ERROR"
+	default:
+		return "FATAL"
+	}
+}
+
+// ParseLevel converts a level name (case-insensitive) to a slog.Level,
+// defaulting to slog.LevelInfo for unrecognized input.
+func ParseLevel(s string) slog.Level {
+	switch strings.ToUpper(s) {
+	case "TRACE":
+		return LevelTrace
+	case "DEBUG":
+		return slog.LevelDebug
+	case "INFO":
+		return slog.LevelInfo
+	case "WARN":
+		return slog.LevelWarn
+	case "ERROR":
+		return slog.LevelError
+	case "FATAL":
+		return LevelFatal
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,54 @@
+package slogr
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// composeReplaceAttr builds a slog.HandlerOptions.ReplaceAttr func that renames
+// the four built-in attribute keys to the ones configured in opts, formats
+// slog.Source as a "file:line" string, and optionally lowercases the level
+// value. userReplaceAttr, if non-nil, is run first so this composes with any
+// caller-supplied ReplaceAttr instead of overwriting it.
+func composeReplaceAttr(opts *Options, userReplaceAttr func(groups []string, a slog.Attr) slog.Attr) func(groups []string, a slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if userReplaceAttr != nil {
+			a = userReplaceAttr(groups, a)
+		}
+
+		// The built-in keys are only ever emitted at the top level of a
+		// record; a user attribute nested under WithGroup that happens to
+		// share one of these names (e.g. "time" or "msg") must not be
+		// mistaken for it.
+		if len(groups) == 0 {
+			switch a.Key {
+			case slog.TimeKey:
+				a.Key = opts.TimeKey
+			case slog.LevelKey:
+				if opts.LowercaseLevels {
+					if level, ok := a.Value.Any().(slog.Level); ok {
+						a.Value = slog.StringValue(levelString(level))
+					}
+				}
+				a.Key = opts.LevelKey
+			case slog.MessageKey:
+				a.Key = opts.MessageKey
+			case slog.SourceKey:
+				if source, ok := a.Value.Any().(*slog.Source); ok {
+					a.Value = slog.StringValue(fmt.Sprintf("%s:%d", source.File, source.Line))
+				}
+				a.Key = opts.SourceKey
+			}
+		}
+
+		return a
+	}
+}
+
+// levelString returns the lowercase ingestion-friendly name for level,
+// including the "trace" and "fatal" levels this package adds below/above
+// slog's own four levels.
+func levelString(level slog.Level) string {
+	return strings.ToLower(levelLabel(level))
+}
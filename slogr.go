@@ -6,6 +6,7 @@ import (
 	"io"
 	"log/slog"
 	"os"
+	"sync/atomic"
 )
 
 // HandlerTepe defienes the type of handler to use
@@ -31,6 +32,31 @@ type Options struct {
 
 	// Additional handler options
 	HandlerOptions *slog.HandlerOptions
+
+	// WithContextAttrs registers context attribute extractors at construction
+	// time, in addition to any registered later via
+	// Logger.RegisterContextAttrFunc.
+	WithContextAttrs []ContextAttrFunc
+
+	// AddTraceAttrs enables trace_id/span_id correlation attributes using
+	// TraceExtractor, or DefaultTraceExtractor if TraceExtractor is nil.
+	AddTraceAttrs bool
+
+	// TraceExtractor, if set, is used instead of DefaultTraceExtractor to pull
+	// the active trace/span id pair from the record's context.
+	TraceExtractor TraceExtractor
+
+	// TimeKey, LevelKey, MessageKey, and SourceKey rename the corresponding
+	// built-in slog attribute keys. They default to slog's own key constants.
+	TimeKey    string
+	LevelKey   string
+	MessageKey string
+	SourceKey  string
+
+	// LowercaseLevels rewrites the level attribute value to a lowercase
+	// string (e.g. "info" instead of "INFO"), as required by log ingestion
+	// pipelines such as Loki, ELK, and Datadog.
+	LowercaseLevels bool
 }
 
 func DefaultOptions() *Options {
@@ -44,6 +70,10 @@ func DefaultOptions() *Options {
 				return a
 			},
 		},
+		TimeKey:    slog.TimeKey,
+		LevelKey:   slog.LevelKey,
+		MessageKey: slog.MessageKey,
+		SourceKey:  slog.SourceKey,
 	}
 }
 
@@ -64,8 +94,11 @@ func GetLevel() slog.Level {
 }
 
 type Logger struct {
-	// level is the minimum level output by this Logger
-	level slog.Level
+	// levelVar backs the minimum level output by this Logger's built-in text
+	// and JSON handlers. It is passed as the Level in slog.HandlerOptions so
+	// SetLevel can adjust it in place without rebuilding the handler; a
+	// CustomHandler does not use it.
+	levelVar *slog.LevelVar
 
 	// shouldPreficMessageWithLevel is whether to include the log level prefix in each log.
 	shouldPreficMessageWithLevel bool
@@ -75,7 +108,32 @@ type Logger struct {
 
 	writerType io.Writer
 
-	slogger *slog.Logger
+	// options is the (defaulted) Options the logger was built from. It is
+	// retained so SetHandler/SetCustomHandler/Clone can reapply trace
+	// correlation, attribute key renaming, and lowercase levels exactly as
+	// New does, instead of silently reverting to slog's raw defaults.
+	options *Options
+
+	// userReplaceAttr is the caller-supplied HandlerOptions.ReplaceAttr
+	// captured before New composed it with key renaming/lowercasing, so that
+	// composition can be rebuilt from scratch on every handler swap.
+	userReplaceAttr func(groups []string, a slog.Attr) slog.Attr
+
+	// contextAttrFuncs are invoked with the incoming ctx on every Log call and
+	// their returned attrs are appended to the record. It is stored behind an
+	// atomic.Pointer, copy-on-write, since Logger.RegisterContextAttrFunc can
+	// be called concurrently with contextAttrHandler.Handle reading it from
+	// every logging goroutine.
+	contextAttrFuncs atomic.Pointer[[]ContextAttrFunc]
+
+	// slogger is swapped atomically by SetHandler/SetCustomHandler so that
+	// concurrent Log calls never observe a partially-constructed handler.
+	slogger atomic.Pointer[slog.Logger]
+
+	// ExitFunc is called by Fatal/Fatalf with the process exit code after the
+	// record has been flushed. It defaults to os.Exit, and can be overridden
+	// in tests to observe a Fatal call without terminating the test process.
+	ExitFunc func(code int)
 }
 
 // New create a new logger with the given threshold and output
@@ -90,12 +148,32 @@ func New(output io.Writer, opts *Options) *Logger {
 		}
 	}
 
+	if opts.TimeKey == "" {
+		opts.TimeKey = slog.TimeKey
+	}
+	if opts.LevelKey == "" {
+		opts.LevelKey = slog.LevelKey
+	}
+	if opts.MessageKey == "" {
+		opts.MessageKey = slog.MessageKey
+	}
+	if opts.SourceKey == "" {
+		opts.SourceKey = slog.SourceKey
+	}
+
+	levelVar := &slog.LevelVar{}
+	levelVar.Set(opts.Level)
+
+	userReplaceAttr := opts.HandlerOptions.ReplaceAttr
+
 	var handler slog.Handler
 
 	// if custom handler is provided use it
 	if opts.CustomHandler != nil {
 		handler = opts.CustomHandler
 	} else {
+		opts.HandlerOptions.Level = levelVar
+		opts.HandlerOptions.ReplaceAttr = composeReplaceAttr(opts, userReplaceAttr)
 		switch opts.HandlerType {
 		case HandlerTypeJSON:
 			handler = slog.NewJSONHandler(output, opts.HandlerOptions)
@@ -104,17 +182,51 @@ func New(output io.Writer, opts *Options) *Logger {
 		}
 	}
 
-	if opts.AddLevelPrefix {
-		handler = &levelPrefixHandler{handler}
-	}
-
-	return &Logger{
-		level:                        opts.Level,
+	logger := &Logger{
+		levelVar:                     levelVar,
 		shouldPreficMessageWithLevel: opts.AddLevelPrefix,
 		handlerType:                  opts.HandlerType,
-		slogger:                      slog.New(handler),
 		writerType:                   output,
+		options:                      opts,
+		userReplaceAttr:              userReplaceAttr,
+	}
+	initialContextAttrFuncs := append([]ContextAttrFunc(nil), opts.WithContextAttrs...)
+	logger.contextAttrFuncs.Store(&initialContextAttrFuncs)
+
+	logger.slogger.Store(slog.New(logger.wrapHandler(handler)))
+
+	return logger
+}
+
+// traceExtractor returns the TraceExtractor this logger was configured with,
+// or nil if trace correlation is disabled.
+func (logger *Logger) traceExtractor() TraceExtractor {
+	if logger.options == nil {
+		return nil
+	}
+	if logger.options.TraceExtractor != nil {
+		return logger.options.TraceExtractor
+	}
+	if logger.options.AddTraceAttrs {
+		return DefaultTraceExtractor
+	}
+	return nil
+}
+
+// wrapHandler applies the logger's level-prefix, trace correlation, and
+// context attribute wrapping to handler, in that order. New, SetHandler, and
+// SetCustomHandler all route through this so that swapping the underlying
+// handler at runtime never silently drops a configured feature.
+func (logger *Logger) wrapHandler(handler slog.Handler) slog.Handler {
+	if logger.shouldPreficMessageWithLevel {
+		handler = &levelPrefixHandler{handler}
 	}
+
+	if extractor := logger.traceExtractor(); extractor != nil {
+		handler = &traceAttrHandler{Handler: handler, extractor: extractor}
+	}
+
+	return &contextAttrHandler{Handler: handler, logger: logger}
 }
 
 // levelPrefixHandler adds a level prefix to log message
@@ -123,15 +235,59 @@ type levelPrefixHandler struct {
 }
 
 func (h *levelPrefixHandler) Handle(ctx context.Context, r slog.Record) error {
-	r.Message = "- " + r.Level.String() + " - " + r.Message
+	r.Message = "- " + levelLabel(r.Level) + " - " + r.Message
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *levelPrefixHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelPrefixHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h *levelPrefixHandler) WithGroup(name string) slog.Handler {
+	return &levelPrefixHandler{h.Handler.WithGroup(name)}
+}
+
+// contextAttrHandler appends attributes extracted from ctx via the logger's
+// registered ContextAttrFuncs to every record before it reaches the
+// underlying handler.
+type contextAttrHandler struct {
+	slog.Handler
+	logger *Logger
+}
+
+func (h *contextAttrHandler) Handle(ctx context.Context, r slog.Record) error {
+	for _, fn := range h.logger.loadContextAttrFuncs() {
+		for _, attr := range fn(ctx) {
+			r.AddAttrs(attr)
+		}
+	}
 	return h.Handler.Handle(ctx, r)
 }
 
+// loadContextAttrFuncs returns a snapshot of the logger's registered
+// ContextAttrFuncs, safe to call concurrently with RegisterContextAttrFunc.
+func (logger *Logger) loadContextAttrFuncs() []ContextAttrFunc {
+	if p := logger.contextAttrFuncs.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+func (h *contextAttrHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextAttrHandler{Handler: h.Handler.WithAttrs(attrs), logger: h.logger}
+}
+
+func (h *contextAttrHandler) WithGroup(name string) slog.Handler {
+	return &contextAttrHandler{Handler: h.Handler.WithGroup(name), logger: h.logger}
+}
+
 func (logger *Logger) SetHandler(output io.Writer, handlerType HandlerType, opts *slog.HandlerOptions) {
 	if opts == nil {
-		opts = &slog.HandlerOptions{
-			Level: logger.level,
-		}
+		opts = &slog.HandlerOptions{}
+	}
+	opts.Level = logger.levelVar
+	if logger.options != nil {
+		opts.ReplaceAttr = composeReplaceAttr(logger.options, logger.userReplaceAttr)
 	}
 
 	var handler slog.Handler
@@ -142,29 +298,26 @@ func (logger *Logger) SetHandler(output io.Writer, handlerType HandlerType, opts
 		handler = slog.NewTextHandler(output, opts)
 	}
 
-	if logger.shouldPreficMessageWithLevel {
-		handler = &levelPrefixHandler{handler}
-	}
-
-	logger.slogger = slog.New(handler)
+	logger.slogger.Store(slog.New(logger.wrapHandler(handler)))
 }
 
 // SetOutput changes the output destination for the logger
 func (logger *Logger) SetOutput(output io.Writer) {
-	logger.SetHandler(output, logger.handlerType, &slog.HandlerOptions{
-		Level: logger.level,
-	})
+	logger.writerType = output
+	logger.SetHandler(output, logger.handlerType, nil)
 }
 
+// SetLevel adjusts the logger's level threshold in place via its
+// slog.LevelVar, so concurrent Log calls observe the new level without any
+// handler rebuild or reallocation. A CustomHandler applies its own level:
+// SetLevel does not change what it logs, though GetLevel reports the new
+// value.
 func (logger *Logger) SetLevel(level slog.Level) {
-	logger.level = level
-	logger.SetHandler(logger.writerType, logger.handlerType, &slog.HandlerOptions{
-		Level: level,
-	})
+	logger.levelVar.Set(level)
 }
 
 func (logger *Logger) GetLevel() slog.Level {
-	return logger.level
+	return logger.levelVar.Level()
 }
 
 func (logger *Logger) GetHandlerType() HandlerType {
@@ -173,21 +326,29 @@ func (logger *Logger) GetHandlerType() HandlerType {
 
 // SetCustomHandler allows setting a custom handler
 func (logger *Logger) SetCustomHandler(handler slog.Handler) {
-	if logger.shouldPreficMessageWithLevel {
-		handler = &levelPrefixHandler{handler}
+	if logger.options != nil {
+		logger.options.CustomHandler = handler
 	}
 
-	logger.slogger = slog.New(handler)
+	logger.slogger.Store(slog.New(logger.wrapHandler(handler)))
 }
 
 func (logger *Logger) Log(ctx context.Context, level slog.Level, msg string, args ...any) {
-	logger.slogger.Log(ctx, level, msg, args...)
+	logger.slogger.Load().Log(ctx, level, msg, args...)
 }
 
 func (logger *Logger) Logf(ctx context.Context, level slog.Level, format string, args ...any) {
 	// Format the message with fmt.Sprintf before passing to the logger
 	formattedMsg := fmt.Sprintf(format, args...)
-	logger.slogger.Log(ctx, level, formattedMsg)
+	logger.slogger.Load().Log(ctx, level, formattedMsg)
+}
+
+func (logger *Logger) Trace(ctx context.Context, msg string, args ...any) {
+	logger.Log(ctx, LevelTrace, msg, args...)
+}
+
+func (logger *Logger) Tracef(ctx context.Context, format string, args ...any) {
+	logger.Logf(ctx, LevelTrace, format, args...)
 }
 
 func (logger *Logger) Debug(ctx context.Context, msg string, args ...any) {
@@ -223,11 +384,22 @@ func (logger *Logger) Errorf(ctx context.Context, format string, args ...any) {
 }
 
 func (logger *Logger) Fatal(ctx context.Context, msg string, args ...any) {
-	logger.Log(ctx, slog.LevelError+4, msg, args...)
+	logger.Log(ctx, LevelFatal, msg, args...)
+	logger.exit(1)
 }
 
 func (logger *Logger) Fatalf(ctx context.Context, format string, args ...any) {
-	logger.Logf(ctx, slog.LevelError+4, format, args...)
+	logger.Logf(ctx, LevelFatal, format, args...)
+	logger.exit(1)
+}
+
+// exit terminates the process via logger.ExitFunc, falling back to os.Exit.
+func (logger *Logger) exit(code int) {
+	if logger.ExitFunc != nil {
+		logger.ExitFunc(code)
+		return
+	}
+	os.Exit(code)
 }
 
 func Log(ctx context.Context, level slog.Level, msg string, args ...any) {
@@ -238,6 +410,14 @@ func Logf(ctx context.Context, level slog.Level, format string, args ...any) {
 	defaultLogger.Logf(ctx, level, format, args...)
 }
 
+func Trace(ctx context.Context, msg string, args ...any) {
+	defaultLogger.Trace(ctx, msg, args...)
+}
+
+func Tracef(ctx context.Context, format string, args ...any) {
+	defaultLogger.Tracef(ctx, format, args...)
+}
+
 func Debug(ctx context.Context, msg string, args ...any) {
 	defaultLogger.Debug(ctx, msg, args...)
 }
@@ -0,0 +1,426 @@
+package slogr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *Options
+	}{
+		{
+			name: "nil options should use defaults",
+			opts: nil,
+		},
+		{
+			name: "custom options",
+			opts: &Options{
+				Level:          slog.LevelDebug,
+				AddLevelPrefix: true,
+				HandlerType:    HandlerTypeJSON,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			logger := New(buf, test.opts)
+			if logger == nil {
+				t.Errorf("expected logger to be non-nil")
+			}
+		})
+	}
+}
+
+func TestLogger_Levels(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    slog.Level
+		message  string
+		contains string
+	}{
+		{
+			name:     "trace level",
+			level:    LevelTrace,
+			message:  "trace message",
+			contains: "TRACE",
+		},
+		{
+			name:     "debug level",
+			level:    slog.LevelDebug,
+			message:  "debug message",
+			contains: "DEBUG",
+		},
+		{
+			name:     "info level",
+			level:    slog.LevelInfo,
+			message:  "info message",
+			contains: "INFO",
+		},
+		{
+			name:     "warn level",
+			level:    slog.LevelWarn,
+			message:  "warn message",
+			contains: "WARN",
+		},
+		{
+			name:     "error level",
+			level:    slog.LevelError,
+			message:  "error message",
+			contains: "ERROR",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			logger := New(buf, &Options{
+				Level:          test.level,
+				AddLevelPrefix: true,
+				HandlerType:    HandlerTypeText,
+			})
+			logger.Log(context.Background(), test.level, test.message)
+			output := buf.String()
+			if !strings.Contains(output, test.contains) {
+				t.Errorf("expected output to contain %s, got %s", test.contains, output)
+			}
+		})
+	}
+}
+
+func TestLogger_HandlerType(t *testing.T) {
+	tests := []struct {
+		name        string
+		handlerType HandlerType
+		checkJSON   bool
+	}{
+		{
+			name:        "text handler",
+			handlerType: HandlerTypeText,
+			checkJSON:   false,
+		},
+		{
+			name:        "json handler",
+			handlerType: HandlerTypeJSON,
+			checkJSON:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			logger := New(buf, &Options{
+				HandlerType: test.handlerType,
+			})
+			logger.Log(context.Background(), slog.LevelInfo, "test message")
+			output := buf.String()
+			if test.checkJSON {
+				var jsonMap map[string]interface{}
+				if err := json.Unmarshal([]byte(output), &jsonMap); err != nil {
+					t.Errorf("expected output to be valid JSON, got %s", output)
+				}
+			} else {
+				if !strings.Contains(output, "INFO") {
+					t.Errorf("expected output to contain INFO, got %s", output)
+				}
+			}
+		})
+	}
+}
+
+func TestLogger_CustomHandler(t *testing.T) {
+	buf := new(bytes.Buffer)
+	customHandler := slog.NewTextHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	})
+	logger := New(buf, nil)
+	logger.SetCustomHandler(customHandler)
+
+	logger.Info(context.Background(), "test message")
+
+	if buf.Len() == 0 {
+		t.Errorf("expected output to be non-empty")
+	}
+}
+
+func TestLogger_SetLevel(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := New(buf, nil)
+
+	// set level to error and try to log Info
+	logger.SetLevel(slog.LevelError)
+	logger.Infof(context.Background(), "this should not be logged")
+
+	if buf.Len() > 0 {
+		t.Error("expected no output for Info level message when level is set to Error")
+	}
+
+	logger.Errorf(context.Background(), "this should be logged")
+	if buf.Len() == 0 {
+		t.Error("expected output for Error level message")
+	}
+}
+
+func TestLogger_SetThreshold(t *testing.T) {
+	ctx := context.Background()
+	buf := new(bytes.Buffer)
+	logger := New(buf, nil)
+	logger.SetLevel(slog.LevelError)
+	logger.Debug(ctx, "this is debug")
+	logger.Debugf(ctx, "this is debug %d", 1)
+	logger.Info(ctx, "this is info")
+	logger.Infof(ctx, "this is info %d", 1)
+	logger.Warn(ctx, "this is warn")
+	logger.Warnf(ctx, "this is warn %d", 1)
+	logger.Error(ctx, "this is error")
+	output := buf.String()
+	if numberOfNewLines := strings.Count(output, "\n"); numberOfNewLines != 1 {
+		t.Error("expected 1 newline, got", numberOfNewLines)
+	}
+}
+
+func TestLogger_SetOutput(t *testing.T) {
+	buf1 := &bytes.Buffer{}
+	buf2 := &bytes.Buffer{}
+
+	logger := New(buf1, nil)
+	logger.Info(context.Background(), "first buffer")
+
+	if buf1.Len() == 0 {
+		t.Error("expected output in first buffer")
+	}
+
+	logger.SetOutput(buf2)
+	logger.Info(context.Background(), "second buffer")
+
+	initialBuf1Size := buf1.Len()
+	if buf2.Len() == 0 {
+		t.Error("expected output in second buffer")
+	}
+	if buf1.Len() != initialBuf1Size {
+		t.Error("expected first buffer to remain unchanged")
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input string
+		want  slog.Level
+	}{
+		{"trace", LevelTrace},
+		{"DEBUG", slog.LevelDebug},
+		{"info", slog.LevelInfo},
+		{"WARN", slog.LevelWarn},
+		{"error", slog.LevelError},
+		{"FATAL", LevelFatal},
+		{"nonsense", slog.LevelInfo},
+	}
+
+	for _, test := range tests {
+		if got := ParseLevel(test.input); got != test.want {
+			t.Errorf("ParseLevel(%q) = %v, want %v", test.input, got, test.want)
+		}
+	}
+}
+
+func TestLogger_CloneKeepsTraceAndKeyRenaming(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := New(buf, &Options{
+		HandlerType:     HandlerTypeJSON,
+		LevelKey:        "severity",
+		LowercaseLevels: true,
+		TraceExtractor: func(context.Context) (string, string, bool) {
+			return "trace-123", "span-456", true
+		},
+	})
+
+	cloneBuf := new(bytes.Buffer)
+	clone := logger.Clone(WithOutput(cloneBuf))
+	clone.Info(context.Background(), "cloned logger message")
+
+	output := cloneBuf.String()
+	if !strings.Contains(output, `"severity":"info"`) {
+		t.Errorf("expected clone to keep renamed lowercase severity key, got %s", output)
+	}
+	if !strings.Contains(output, "trace-123") || !strings.Contains(output, "span-456") {
+		t.Errorf("expected clone to keep trace correlation, got %s", output)
+	}
+}
+
+func TestLogger_KeyRenamingSurvivesSetOutput(t *testing.T) {
+	buf1 := new(bytes.Buffer)
+	logger := New(buf1, &Options{
+		HandlerType:     HandlerTypeJSON,
+		LevelKey:        "severity",
+		LowercaseLevels: true,
+	})
+
+	buf2 := new(bytes.Buffer)
+	logger.SetOutput(buf2)
+	logger.Info(context.Background(), "after output swap")
+
+	output := buf2.String()
+	if !strings.Contains(output, `"severity":"info"`) {
+		t.Errorf("expected renamed lowercase severity key to survive SetOutput, got %s", output)
+	}
+}
+
+func TestComposeReplaceAttr_IgnoresNestedGroupAttrs(t *testing.T) {
+	opts := &Options{
+		TimeKey:    "ts",
+		LevelKey:   "severity",
+		MessageKey: "message",
+		SourceKey:  "caller",
+	}
+	replace := composeReplaceAttr(opts, nil)
+
+	a := replace([]string{"request"}, slog.String("time", "not-the-builtin-time"))
+	if a.Key != "time" {
+		t.Errorf("expected nested group attr named %q to be left alone, got key %q", "time", a.Key)
+	}
+
+	a = replace(nil, slog.String(slog.TimeKey, "2024-01-01"))
+	if a.Key != "ts" {
+		t.Errorf("expected top-level time key to be renamed to %q, got %q", "ts", a.Key)
+	}
+}
+
+func TestLogger_TraceAttrsSurviveSetCustomHandler(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := New(buf, &Options{
+		HandlerType: HandlerTypeJSON,
+		TraceExtractor: func(context.Context) (string, string, bool) {
+			return "trace-123", "span-456", true
+		},
+	})
+
+	logger.SetCustomHandler(slog.NewJSONHandler(buf, &slog.HandlerOptions{}))
+	logger.Info(context.Background(), "after custom handler swap")
+
+	output := buf.String()
+	if !strings.Contains(output, "trace-123") || !strings.Contains(output, "span-456") {
+		t.Errorf("expected trace_id/span_id to survive SetCustomHandler, got %s", output)
+	}
+}
+
+func TestLogger_RegisterContextAttrFuncConcurrent(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := New(buf, nil)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			logger.RegisterContextAttrFunc(func(context.Context) []slog.Attr {
+				return []slog.Attr{slog.String("request_id", "abc")}
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			logger.Info(ctx, "concurrent log")
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLogger_WithBindsAttrsAndGroup(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := New(buf, &Options{HandlerType: HandlerTypeJSON})
+
+	child := logger.With("component", "broker").WithGroup("request").With("id", "abc123")
+	child.Info(context.Background(), "handled")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("expected valid JSON, got %s: %v", buf.String(), err)
+	}
+	if got["component"] != "broker" {
+		t.Errorf("expected top-level component attr from With, got %v", got["component"])
+	}
+	request, ok := got["request"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected request group in output, got %v", got)
+	}
+	if request["id"] != "abc123" {
+		t.Errorf("expected id attr nested under request group, got %v", request["id"])
+	}
+}
+
+func TestLogger_WithChildKeepsTraceAndKeyRenaming(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := New(buf, &Options{
+		HandlerType:     HandlerTypeJSON,
+		LevelKey:        "severity",
+		LowercaseLevels: true,
+		TraceExtractor: func(context.Context) (string, string, bool) {
+			return "trace-123", "span-456", true
+		},
+	})
+
+	child := logger.With("component", "broker")
+
+	childBuf := new(bytes.Buffer)
+	child.SetOutput(childBuf)
+	child.Info(context.Background(), "child message")
+
+	output := childBuf.String()
+	if !strings.Contains(output, `"severity":"info"`) {
+		t.Errorf("expected child of With to keep renamed lowercase severity key, got %s", output)
+	}
+	if !strings.Contains(output, "trace-123") || !strings.Contains(output, "span-456") {
+		t.Errorf("expected child of With to keep trace correlation, got %s", output)
+	}
+}
+
+func TestLogger_WithContextAttrsRegisteredAtConstruction(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := New(buf, &Options{
+		HandlerType: HandlerTypeJSON,
+		WithContextAttrs: []ContextAttrFunc{
+			func(context.Context) []slog.Attr {
+				return []slog.Attr{slog.String("request_id", "req-789")}
+			},
+		},
+	})
+
+	logger.Info(context.Background(), "constructed with context attrs")
+
+	output := buf.String()
+	if !strings.Contains(output, `"request_id":"req-789"`) {
+		t.Errorf("expected construction-time context attr to appear in output, got %s", output)
+	}
+}
+
+func TestLogger_FatalExitFunc(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := New(buf, nil)
+
+	var exitCode int
+	exited := false
+	logger.ExitFunc = func(code int) {
+		exited = true
+		exitCode = code
+	}
+
+	logger.Fatal(context.Background(), "something went wrong")
+
+	if !exited {
+		t.Fatal("expected ExitFunc to be called")
+	}
+	if exitCode != 1 {
+		t.Errorf("expected exit code 1, got %d", exitCode)
+	}
+	if !strings.Contains(buf.String(), "something went wrong") {
+		t.Errorf("expected fatal message to be flushed before exit, got %s", buf.String())
+	}
+}
@@ -0,0 +1,38 @@
+package slogr
+
+import (
+	"context"
+	"log/slog"
+)
+
+// TraceExtractor pulls the active trace/span id pair from ctx for span
+// correlation. ok reports whether a trace was found.
+type TraceExtractor func(ctx context.Context) (traceID, spanID string, ok bool)
+
+// DefaultTraceExtractor is the TraceExtractor used when Options.AddTraceAttrs
+// is true and no Options.TraceExtractor is supplied. It is nil unless this
+// module is built with the otel build tag, which keeps the base module free
+// of the OpenTelemetry dependency by default.
+var DefaultTraceExtractor TraceExtractor
+
+// traceAttrHandler adds trace_id/span_id attributes sourced from ctx via
+// extractor to every record before it reaches the underlying handler.
+type traceAttrHandler struct {
+	slog.Handler
+	extractor TraceExtractor
+}
+
+func (h *traceAttrHandler) Handle(ctx context.Context, r slog.Record) error {
+	if traceID, spanID, ok := h.extractor(ctx); ok {
+		r.AddAttrs(slog.String("trace_id", traceID), slog.String("span_id", spanID))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *traceAttrHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &traceAttrHandler{Handler: h.Handler.WithAttrs(attrs), extractor: h.extractor}
+}
+
+func (h *traceAttrHandler) WithGroup(name string) slog.Handler {
+	return &traceAttrHandler{Handler: h.Handler.WithGroup(name), extractor: h.extractor}
+}
@@ -0,0 +1,19 @@
+//go:build otel
+
+package slogr
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func init() {
+	DefaultTraceExtractor = func(ctx context.Context) (string, string, bool) {
+		sc := trace.SpanContextFromContext(ctx)
+		if !sc.IsValid() {
+			return "", "", false
+		}
+		return sc.TraceID().String(), sc.SpanID().String(), true
+	}
+}